@@ -0,0 +1,180 @@
+// Package draw mirrors the standard library's [image/draw] package, but
+// operates on [imggg.Rectangle] and [imggg.Point] so that callers who track
+// geometry in floating point can composite at sub-pixel offsets.
+package draw
+
+import (
+	"image"
+	"image/color"
+	stddraw "image/draw"
+	"math"
+
+	"github.com/greenthepear/imggg"
+)
+
+// Image is an [image.Image] with a Set method to change a single pixel. It is
+// the same interface as [image/draw.Image].
+type Image = stddraw.Image
+
+// Op is a Porter-Duff compositing operator.
+type Op = stddraw.Op
+
+const (
+	// Over specifies ``(src in mask) over dst''.
+	Over = stddraw.Over
+	// Src specifies ``src in mask''.
+	Src = stddraw.Src
+)
+
+// Draw calls DrawMask with a nil mask.
+func Draw[V imggg.Number](dst Image, r imggg.Rectangle[V], src image.Image, sp imggg.Point[V], op Op) {
+	DrawMask(dst, r, src, sp, nil, imggg.Point[V]{}, op)
+}
+
+// DrawMask aligns r.Min in dst with sp in src and mp in mask and then
+// replaces the rectangle r in dst with the result of a Porter-Duff
+// composition. A nil mask is treated as opaque.
+//
+// r is first widened to the smallest [image.Rectangle] that contains it
+// (flooring Min, ceiling Max, so a fractional r never loses a row or column
+// of coverage) and clipped against dst.Bounds(), src.Bounds() translated by
+// sp, and (if non-nil) mask.Bounds() translated by mp - the same way
+// [image/draw.DrawMask]'s internal clip does, so that destination pixels
+// falling outside src or mask are left untouched rather than sampled as
+// transparent. sp and mp are translated by the same deltas so that they stay
+// aligned with the clipped rectangle. If, after clipping, sp and mp both
+// land on integer pixel coordinates, DrawMask defers to
+// [image/draw.DrawMask], which gets the standard library's fast paths for
+// *[image.RGBA] destinations. Otherwise src and mask are sampled bilinearly,
+// so that non-integer offsets are composited smoothly instead of snapping to
+// the nearest pixel.
+func DrawMask[V imggg.Number](dst Image, r imggg.Rectangle[V], src image.Image, sp imggg.Point[V], mask image.Image, mp imggg.Point[V], op Op) {
+	clipped := outerBounds(r).Intersect(dst.Bounds())
+	if clipped.Empty() {
+		return
+	}
+
+	delta := imggg.Pt(V(clipped.Min.X), V(clipped.Min.Y)).Sub(r.Min)
+	sp = sp.Add(delta)
+	mp = mp.Add(delta)
+
+	orig := clipped.Min
+	clipped = clipped.Intersect(src.Bounds().Add(orig.Sub(sp.Std())))
+	if mask != nil {
+		clipped = clipped.Intersect(mask.Bounds().Add(orig.Sub(mp.Std())))
+	}
+	if clipped.Empty() {
+		return
+	}
+	shift := imggg.Pt(V(clipped.Min.X-orig.X), V(clipped.Min.Y-orig.Y))
+	sp = sp.Add(shift)
+	mp = mp.Add(shift)
+
+	if isIntegral(sp) && isIntegral(mp) {
+		stddraw.DrawMask(dst, clipped, src, sp.Std(), mask, mp.Std(), op)
+		return
+	}
+
+	drawBilinear(dst, clipped, src, sp, mask, mp, op)
+}
+
+// outerBounds returns the smallest [image.Rectangle] containing r, by
+// flooring r.Min and ceiling r.Max. Using [Rectangle.Std]'s truncating cast
+// here instead would round a fractional Min or Max toward zero rather than
+// outward, silently dropping the last row or column of a rectangle like
+// imggg.Rect(0, 0, 3.7, 3.7).
+func outerBounds[V imggg.Number](r imggg.Rectangle[V]) image.Rectangle {
+	return image.Rect(
+		int(math.Floor(float64(r.Min.X))),
+		int(math.Floor(float64(r.Min.Y))),
+		int(math.Ceil(float64(r.Max.X))),
+		int(math.Ceil(float64(r.Max.Y))),
+	)
+}
+
+// isIntegral reports whether p falls exactly on a pixel, i.e. has no
+// fractional component in either axis.
+func isIntegral[V imggg.Number](p imggg.Point[V]) bool {
+	x, y := float64(p.X), float64(p.Y)
+	return x == math.Trunc(x) && y == math.Trunc(y)
+}
+
+// drawBilinear composites src (and, if non-nil, mask) onto dst over the
+// pixel rectangle r, sampling src and mask bilinearly at their respective
+// fractional offsets.
+func drawBilinear[V imggg.Number](dst Image, r image.Rectangle, src image.Image, sp imggg.Point[V], mask image.Image, mp imggg.Point[V], op Op) {
+	sx0, sy0 := float64(sp.X), float64(sp.Y)
+	mx0, my0 := float64(mp.X), float64(mp.Y)
+
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		sy := sy0 + float64(y-r.Min.Y)
+		my := my0 + float64(y-r.Min.Y)
+		for x := r.Min.X; x < r.Max.X; x++ {
+			sx := sx0 + float64(x-r.Min.X)
+			mx := mx0 + float64(x-r.Min.X)
+
+			sr, sg, sb, sa := sampleBilinear(src, sx, sy)
+			if mask != nil {
+				_, _, _, ma := sampleBilinear(mask, mx, my)
+				sr = uint32(uint64(sr) * uint64(ma) / 0xffff)
+				sg = uint32(uint64(sg) * uint64(ma) / 0xffff)
+				sb = uint32(uint64(sb) * uint64(ma) / 0xffff)
+				sa = uint32(uint64(sa) * uint64(ma) / 0xffff)
+			}
+
+			if op == Over {
+				dr, dg, db, da := dst.At(x, y).RGBA()
+				a := 0xffff - sa
+				sr += uint32(uint64(dr) * uint64(a) / 0xffff)
+				sg += uint32(uint64(dg) * uint64(a) / 0xffff)
+				sb += uint32(uint64(db) * uint64(a) / 0xffff)
+				sa += uint32(uint64(da) * uint64(a) / 0xffff)
+			}
+
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(sr),
+				G: uint16(sg),
+				B: uint16(sb),
+				A: uint16(sa),
+			})
+		}
+	}
+}
+
+// sampleBilinear returns the alpha-premultiplied color of img at the
+// fractional coordinates (x, y), interpolating between the pixel at
+// (floor(x), floor(y)) and its three neighbors below and to the right, so
+// that integer coordinates land exactly on a pixel with no blending.
+// Coordinates outside img's bounds are treated as transparent.
+func sampleBilinear(img image.Image, x, y float64) (r, g, b, a uint32) {
+	x0, y0 := math.Floor(x), math.Floor(y)
+	fx, fy := x-x0, y-y0
+
+	c00r, c00g, c00b, c00a := at(img, int(x0), int(y0))
+	c10r, c10g, c10b, c10a := at(img, int(x0)+1, int(y0))
+	c01r, c01g, c01b, c01a := at(img, int(x0), int(y0)+1)
+	c11r, c11g, c11b, c11a := at(img, int(x0)+1, int(y0)+1)
+
+	r = lerp2(c00r, c10r, c01r, c11r, fx, fy)
+	g = lerp2(c00g, c10g, c01g, c11g, fx, fy)
+	b = lerp2(c00b, c10b, c01b, c11b, fx, fy)
+	a = lerp2(c00a, c10a, c01a, c11a, fx, fy)
+	return
+}
+
+// at returns the color at (x, y), or transparent if that point is outside
+// img's bounds.
+func at(img image.Image, x, y int) (r, g, b, a uint32) {
+	if (image.Point{X: x, Y: y}).In(img.Bounds()) {
+		return img.At(x, y).RGBA()
+	}
+	return 0, 0, 0, 0
+}
+
+// lerp2 bilinearly interpolates the four corner values at fractional
+// position (fx, fy) within the unit square.
+func lerp2(c00, c10, c01, c11 uint32, fx, fy float64) uint32 {
+	top := float64(c00)*(1-fx) + float64(c10)*fx
+	bottom := float64(c01)*(1-fx) + float64(c11)*fx
+	return uint32(top*(1-fy) + bottom*fy)
+}
@@ -0,0 +1,76 @@
+package draw
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/greenthepear/imggg"
+)
+
+func TestDrawMaskClipsFractionalRectOutward(t *testing.T) {
+	dst := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	src := image.NewUniform(color.RGBA{0xff, 0, 0, 0xff})
+
+	Draw(dst, imggg.Rect(0.0, 0.0, 3.7, 3.7), src, imggg.Pt(0.0, 0.0), Src)
+
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			_, _, _, a := dst.At(x, y).RGBA()
+			if a == 0 {
+				t.Errorf("pixel (%d,%d) left transparent, want drawn", x, y)
+			}
+		}
+	}
+}
+
+func TestDrawMaskEmptyIntersectionIsNoop(t *testing.T) {
+	dst := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	src := image.NewUniform(color.RGBA{0xff, 0, 0, 0xff})
+
+	Draw(dst, imggg.Rect(10.0, 10.0, 12.0, 12.0), src, imggg.Pt(0.0, 0.0), Src)
+
+	if _, _, _, a := dst.At(0, 0).RGBA(); a != 0 {
+		t.Errorf("expected untouched dst to stay transparent, got alpha %d", a)
+	}
+}
+
+func TestDrawMaskBilinearBlendsFractionalOffset(t *testing.T) {
+	dst := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	src.SetRGBA(0, 0, color.RGBA{0, 0, 0, 0xff})
+	src.SetRGBA(1, 0, color.RGBA{0xff, 0xff, 0xff, 0xff})
+
+	Draw(dst, imggg.Rect(0.0, 0.0, 1.0, 1.0), src, imggg.Pt(0.5, 0.0), Src)
+
+	r, _, _, _ := dst.At(0, 0).RGBA()
+	if r == 0 || r == 0xffff {
+		t.Errorf("got r=%d, want a blend strictly between black and white", r)
+	}
+}
+
+func TestDrawMaskBilinearLeavesOutOfSourceBoundsUntouched(t *testing.T) {
+	dst := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	red := color.RGBA{0xff, 0, 0, 0xff}
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			dst.SetRGBA(x, y, red)
+		}
+	}
+
+	// A 2x2 src drawn with a fractional sp (forcing the bilinear path) over
+	// the full 4x4 dst: pixels outside the translated src bounds must be
+	// left as-is, not cleared to transparent.
+	src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 2; x++ {
+			src.SetRGBA(x, y, color.RGBA{0, 0xff, 0, 0xff})
+		}
+	}
+
+	Draw(dst, imggg.Rect(0.0, 0.0, 4.0, 4.0), src, imggg.Pt(0.5, 0.5), Src)
+
+	if got := dst.RGBAAt(3, 3); got != red {
+		t.Errorf("pixel (3,3) outside src bounds: got %v, want untouched %v", got, red)
+	}
+}
@@ -0,0 +1,78 @@
+package imggg
+
+import "golang.org/x/image/math/fixed"
+
+// Int26_6 is a signed 26.6 fixed-point number: the low 6 bits are the
+// fractional part and the remaining bits are the integer part. It satisfies
+// [Number] (its underlying type is int32, so it is a constraints.Integer),
+// which lets it be used as the coordinate type of [Point] and [Rectangle],
+// so geometry from golang.org/x/image/math/fixed - font metrics, vector
+// rasterizers - can move through this package without losing subpixel
+// precision.
+type Int26_6 int32
+
+// I26_6 returns the fixed-point value equal to the integer i.
+func I26_6(i int) Int26_6 {
+	return Int26_6(i << 6)
+}
+
+// Floor returns the greatest integer value <= x.
+func (x Int26_6) Floor() int {
+	return int(x >> 6)
+}
+
+// Round returns the nearest integer value to x. Ties are rounded up (toward
+// +Inf), not away from zero, so e.g. Round(-1.5) is -1.
+func (x Int26_6) Round() int {
+	return int((x + 1<<5) >> 6)
+}
+
+// Ceil returns the least integer value >= x.
+func (x Int26_6) Ceil() int {
+	return int((x + 1<<6 - 1) >> 6)
+}
+
+// Int52_12 is a signed 52.12 fixed-point number: the low 12 bits are the
+// fractional part and the remaining bits are the integer part.
+type Int52_12 int64
+
+// I52_12 returns the fixed-point value equal to the integer i.
+func I52_12(i int) Int52_12 {
+	return Int52_12(i << 12)
+}
+
+// Floor returns the greatest integer value <= x.
+func (x Int52_12) Floor() int {
+	return int(x >> 12)
+}
+
+// Round returns the nearest integer value to x. Ties are rounded up (toward
+// +Inf), not away from zero, so e.g. Round(-1.5) is -1.
+func (x Int52_12) Round() int {
+	return int((x + 1<<11) >> 12)
+}
+
+// Ceil returns the least integer value >= x.
+func (x Int52_12) Ceil() int {
+	return int((x + 1<<12 - 1) >> 12)
+}
+
+// FromFixed26_6 converts a fixed.Point26_6 to a Point[Int26_6].
+func FromFixed26_6(p fixed.Point26_6) Point[Int26_6] {
+	return Point[Int26_6]{X: Int26_6(p.X), Y: Int26_6(p.Y)}
+}
+
+// ToFixed26_6 converts p to a fixed.Point26_6.
+func ToFixed26_6(p Point[Int26_6]) fixed.Point26_6 {
+	return fixed.Point26_6{X: fixed.Int26_6(p.X), Y: fixed.Int26_6(p.Y)}
+}
+
+// FromFixed52_12 converts a fixed.Point52_12 to a Point[Int52_12].
+func FromFixed52_12(p fixed.Point52_12) Point[Int52_12] {
+	return Point[Int52_12]{X: Int52_12(p.X), Y: Int52_12(p.Y)}
+}
+
+// ToFixed52_12 converts p to a fixed.Point52_12.
+func ToFixed52_12(p Point[Int52_12]) fixed.Point52_12 {
+	return fixed.Point52_12{X: fixed.Int52_12(p.X), Y: fixed.Int52_12(p.Y)}
+}
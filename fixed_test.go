@@ -0,0 +1,55 @@
+package imggg
+
+import (
+	"testing"
+
+	"golang.org/x/image/math/fixed"
+)
+
+func TestInt26_6Rounding(t *testing.T) {
+	x := I26_6(3) + Int26_6(32) // 3.5
+	if got := x.Floor(); got != 3 {
+		t.Errorf("Floor(3.5): got %d, want 3", got)
+	}
+	if got := x.Ceil(); got != 4 {
+		t.Errorf("Ceil(3.5): got %d, want 4", got)
+	}
+	if got := x.Round(); got != 4 {
+		t.Errorf("Round(3.5): got %d, want 4", got)
+	}
+
+	// Ties round up (toward +Inf), not away from zero.
+	neg := I26_6(-2) + Int26_6(32) // -1.5
+	if got := neg.Round(); got != -1 {
+		t.Errorf("Round(-1.5): got %d, want -1", got)
+	}
+}
+
+func TestInt52_12Rounding(t *testing.T) {
+	x := I52_12(3) + Int52_12(1<<11) // 3.5
+	if got := x.Round(); got != 4 {
+		t.Errorf("Round(3.5): got %d, want 4", got)
+	}
+
+	neg := I52_12(-2) + Int52_12(1<<11) // -1.5
+	if got := neg.Round(); got != -1 {
+		t.Errorf("Round(-1.5): got %d, want -1", got)
+	}
+}
+
+func TestFixedPointRoundTrip(t *testing.T) {
+	p := Pt(I26_6(3), I26_6(-7))
+	if got := FromFixed26_6(ToFixed26_6(p)); got != p {
+		t.Errorf("26.6 round trip: got %v, want %v", got, p)
+	}
+
+	q := Pt(I52_12(3), I52_12(-7))
+	if got := FromFixed52_12(ToFixed52_12(q)); got != q {
+		t.Errorf("52.12 round trip: got %v, want %v", got, q)
+	}
+
+	fp := fixed.Point26_6{X: 100, Y: -200}
+	if got := ToFixed26_6(FromFixed26_6(fp)); got != fp {
+		t.Errorf("fixed.Point26_6 round trip: got %v, want %v", got, fp)
+	}
+}
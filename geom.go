@@ -63,17 +63,27 @@ func (p Point[V]) In(r Rectangle[V]) bool {
 		r.Min.Y <= p.Y && p.Y < r.Max.Y
 }
 
+// mod returns a modulo b: a%b for integer V, since % does not apply to
+// floats, and math.Mod(a, b) for floating-point V.
+func mod[V Number](a, b V) V {
+	// V(1)/2 is non-zero only for floating-point V, named float types
+	// included.
+	if one := V(1); one/2 != 0 {
+		return V(math.Mod(float64(a), float64(b)))
+	}
+	return V(int64(a) % int64(b))
+}
+
 // Mod returns the point q in r such that p.X-q.X is a multiple of r's width
 // and p.Y-q.Y is a multiple of r's height.
 func (p Point[V]) Mod(r Rectangle[V]) Point[V] {
 	w, h := r.Dx(), r.Dy()
 	p = p.Sub(r.Min)
-	// Since % doesn't work on floats, TODO: avoid cast
-	math.Mod(float64(p.X), float64(w))
+	p.X = mod(p.X, w)
 	if p.X < 0 {
 		p.X += w
 	}
-	math.Mod(float64(p.Y), float64(w))
+	p.Y = mod(p.Y, h)
 	if p.Y < 0 {
 		p.Y += h
 	}
@@ -85,6 +95,81 @@ func (p Point[V]) Eq(q Point[V]) bool {
 	return p == q
 }
 
+// Dot returns the dot (scalar) product of p and q.
+func (p Point[V]) Dot(q Point[V]) V {
+	return p.X*q.X + p.Y*q.Y
+}
+
+// Cross returns the z-component of the 3D cross product of p and q, treating
+// both as vectors in the z=0 plane.
+func (p Point[V]) Cross(q Point[V]) V {
+	return p.X*q.Y - p.Y*q.X
+}
+
+// LengthSq returns the square of p's length (its distance from the origin).
+// Unlike [Point.Length] it needs no square root, so it returns a V and is
+// exact for integer V.
+func (p Point[V]) LengthSq() V {
+	return p.Dot(p)
+}
+
+// Length returns p's length (its distance from the origin).
+func (p Point[V]) Length() float64 {
+	return math.Sqrt(float64(p.LengthSq()))
+}
+
+// DistanceSq returns the square of the distance between p and q.
+func (p Point[V]) DistanceSq(q Point[V]) V {
+	return p.Sub(q).LengthSq()
+}
+
+// Distance returns the distance between p and q.
+func (p Point[V]) Distance(q Point[V]) float64 {
+	return p.Sub(q).Length()
+}
+
+// Normalize returns p scaled to unit length. It returns the zero Point if p
+// is the zero vector.
+func (p Point[V]) Normalize() Point[float64] {
+	l := p.Length()
+	if l == 0 {
+		return Point[float64]{}
+	}
+	return Point[float64]{float64(p.X) / l, float64(p.Y) / l}
+}
+
+// Perp returns p rotated 90 degrees clockwise as drawn on screen (this
+// package's axes increase right and down, see [Point]): (x, y) becomes
+// (-y, x).
+func (p Point[V]) Perp() Point[V] {
+	return Point[V]{-p.Y, p.X}
+}
+
+// Lerp returns the point that is the fraction t of the way from p to q, so
+// that t=0 returns p and t=1 returns q.
+func (p Point[V]) Lerp(q Point[V], t float64) Point[float64] {
+	return Point[float64]{
+		float64(p.X) + (float64(q.X)-float64(p.X))*t,
+		float64(p.Y) + (float64(q.Y)-float64(p.Y))*t,
+	}
+}
+
+// Rotate returns p rotated by theta radians around the origin.
+func (p Point[V]) Rotate(theta float64) Point[float64] {
+	sin, cos := math.Sincos(theta)
+	x, y := float64(p.X), float64(p.Y)
+	return Point[float64]{
+		x*cos - y*sin,
+		x*sin + y*cos,
+	}
+}
+
+// Angle returns the angle in radians between the vectors p and q, in the
+// range [0, pi].
+func (p Point[V]) Angle(q Point[V]) float64 {
+	return math.Acos(float64(p.Dot(q)) / (p.Length() * q.Length()))
+}
+
 // Pt is shorthand for [Point]{X, Y}.
 func Pt[V Number](X, Y V) Point[V] {
 	return Point[V]{X, Y}
@@ -2,9 +2,14 @@ package imggg
 
 import (
 	"image"
+	"math"
 	"testing"
 )
 
+func closeEnough(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
 // TODO: Tests maybe lol
 func TestGeom(t *testing.T) {
 	// Readme example test
@@ -23,3 +28,65 @@ func TestGeom(t *testing.T) {
 		t.Errorf("wrong dot product")
 	}
 }
+
+func TestPointMod(t *testing.T) {
+	r := Rect(0, 0, 10, 5)
+	if got := Pt(-3, -2).Mod(r); got != Pt(7, 3) {
+		t.Errorf("Mod with negative coordinates: got %v, want (7,3)", got)
+	}
+
+	fr := Rect(0.0, 0.0, 2.5, 2.5)
+	if got := Pt(-1.0, 6.0).Mod(fr); got != Pt(1.5, 1.0) {
+		t.Errorf("Mod with float width 2.5: got %v, want (1.5,1.0)", got)
+	}
+
+	for _, p := range []Point[int]{Pt(0, 0), Pt(13, -7), Pt(-13, 7), Pt(100, 100)} {
+		if got := p.Mod(r); !got.In(r) {
+			t.Errorf("%v.Mod(%v) = %v, not in r", p, r, got)
+		}
+	}
+}
+
+func TestPointVector(t *testing.T) {
+	if got := Pt(1, 0).Cross(Pt(0, 1)); got != 1 {
+		t.Errorf("Cross: got %v, want 1", got)
+	}
+
+	p := Pt(3, 4)
+	if got := p.LengthSq(); got != 25 {
+		t.Errorf("LengthSq: got %v, want 25", got)
+	}
+	if got := p.Length(); got != 5 {
+		t.Errorf("Length: got %v, want 5", got)
+	}
+
+	if got := Pt(0, 0).DistanceSq(p); got != 25 {
+		t.Errorf("DistanceSq: got %v, want 25", got)
+	}
+	if got := Pt(0, 0).Distance(p); got != 5 {
+		t.Errorf("Distance: got %v, want 5", got)
+	}
+
+	if n := p.Normalize(); !closeEnough(n.X, 0.6) || !closeEnough(n.Y, 0.8) {
+		t.Errorf("Normalize: got %v, want (0.6,0.8)", n)
+	}
+	if z := (Point[float64]{}).Normalize(); z != (Point[float64]{}) {
+		t.Errorf("Normalize of zero vector: got %v, want zero point", z)
+	}
+
+	if got := Pt(1, 0).Perp(); got != Pt(0, 1) {
+		t.Errorf("Perp: got %v, want (0,1)", got)
+	}
+
+	if l := Pt(0.0, 0.0).Lerp(Pt(10.0, 10.0), 0.5); l != Pt(5.0, 5.0) {
+		t.Errorf("Lerp: got %v, want (5,5)", l)
+	}
+
+	if r := Pt(1, 0).Rotate(math.Pi / 2); !closeEnough(r.X, 0) || !closeEnough(r.Y, 1) {
+		t.Errorf("Rotate: got %v, want (0,1)", r)
+	}
+
+	if a := Pt(1, 0).Angle(Pt(0, 1)); !closeEnough(a, math.Pi/2) {
+		t.Errorf("Angle: got %v, want pi/2", a)
+	}
+}
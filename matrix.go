@@ -0,0 +1,111 @@
+package imggg
+
+import "math"
+
+// A Matrix is a 2D affine transform
+//
+//	[ A  C  Tx ]
+//	[ B  D  Ty ]
+//
+// that maps a point (x, y) to (A*x + C*y + Tx, B*x + D*y + Ty).
+type Matrix[V Number] struct {
+	A, B, C, D, Tx, Ty V
+}
+
+// Identity returns the identity transform.
+func Identity[V Number]() Matrix[V] {
+	return Matrix[V]{A: 1, D: 1}
+}
+
+// Translation returns a transform that translates by p.
+func Translation[V Number](p Point[V]) Matrix[V] {
+	return Matrix[V]{A: 1, D: 1, Tx: p.X, Ty: p.Y}
+}
+
+// Scaling returns a transform that scales by sx horizontally and sy
+// vertically, around the origin.
+func Scaling[V Number](sx, sy V) Matrix[V] {
+	return Matrix[V]{A: sx, D: sy}
+}
+
+// Rotation returns a transform that rotates by theta radians around the
+// origin.
+func Rotation[V Number](theta float64) Matrix[V] {
+	sin, cos := math.Sincos(theta)
+	return Matrix[V]{A: V(cos), B: V(sin), C: V(-sin), D: V(cos)}
+}
+
+// Shear returns a transform that shears by kx horizontally and ky
+// vertically.
+func Shear[V Number](kx, ky V) Matrix[V] {
+	return Matrix[V]{A: 1, B: ky, C: kx, D: 1}
+}
+
+// Mul returns the transform that first applies n and then m, so that
+// m.Mul(n).TransformPoint(p) equals m.TransformPoint(n.TransformPoint(p)).
+func (m Matrix[V]) Mul(n Matrix[V]) Matrix[V] {
+	return Matrix[V]{
+		A:  m.A*n.A + m.C*n.B,
+		B:  m.B*n.A + m.D*n.B,
+		C:  m.A*n.C + m.C*n.D,
+		D:  m.B*n.C + m.D*n.D,
+		Tx: m.A*n.Tx + m.C*n.Ty + m.Tx,
+		Ty: m.B*n.Tx + m.D*n.Ty + m.Ty,
+	}
+}
+
+// Inverse returns the transform that undoes m. If m is degenerate, i.e. its
+// 2x2 linear part has a zero determinant, Inverse returns the zero Matrix
+// instead of dividing by zero, which would panic for integer V.
+func (m Matrix[V]) Inverse() Matrix[V] {
+	det := m.A*m.D - m.B*m.C
+	if det == 0 {
+		return Matrix[V]{}
+	}
+	a := m.D / det
+	b := -m.B / det
+	c := -m.C / det
+	d := m.A / det
+	return Matrix[V]{
+		A: a, B: b, C: c, D: d,
+		Tx: -(a*m.Tx + c*m.Ty),
+		Ty: -(b*m.Tx + d*m.Ty),
+	}
+}
+
+// TransformPoint returns p transformed by m.
+func (m Matrix[V]) TransformPoint(p Point[V]) Point[V] {
+	return Point[V]{
+		m.A*p.X + m.C*p.Y + m.Tx,
+		m.B*p.X + m.D*p.Y + m.Ty,
+	}
+}
+
+// TransformRectangle returns the axis-aligned bounding box of r's four
+// corners after each has been transformed by m. Unlike [Rectangle.Add], this
+// lets r be rotated or sheared and still come back as a well-formed
+// Rectangle.
+func (m Matrix[V]) TransformRectangle(r Rectangle[V]) Rectangle[V] {
+	corners := [4]Point[V]{
+		m.TransformPoint(r.Min),
+		m.TransformPoint(Point[V]{r.Max.X, r.Min.Y}),
+		m.TransformPoint(Point[V]{r.Min.X, r.Max.Y}),
+		m.TransformPoint(r.Max),
+	}
+	out := Rectangle[V]{corners[0], corners[0]}
+	for _, c := range corners[1:] {
+		if c.X < out.Min.X {
+			out.Min.X = c.X
+		}
+		if c.Y < out.Min.Y {
+			out.Min.Y = c.Y
+		}
+		if c.X > out.Max.X {
+			out.Max.X = c.X
+		}
+		if c.Y > out.Max.Y {
+			out.Max.Y = c.Y
+		}
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+package imggg
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMatrixTransformPoint(t *testing.T) {
+	m := Translation(Pt(2.0, 3.0)).Mul(Scaling(2.0, 2.0))
+	if got := m.TransformPoint(Pt(1.0, 1.0)); !closeEnough(got.X, 4) || !closeEnough(got.Y, 5) {
+		t.Errorf("TransformPoint: got %v, want (4,5)", got)
+	}
+}
+
+func TestMatrixRotation(t *testing.T) {
+	m := Rotation[float64](math.Pi / 2)
+	got := m.TransformPoint(Pt(1.0, 0.0))
+	if !closeEnough(got.X, 0) || !closeEnough(got.Y, 1) {
+		t.Errorf("Rotation: got %v, want (0,1)", got)
+	}
+}
+
+func TestMatrixInverse(t *testing.T) {
+	m := Translation(Pt(2.0, 3.0)).Mul(Scaling(2.0, 4.0))
+	inv := m.Inverse()
+	p := Pt(5.0, -1.0)
+	got := inv.TransformPoint(m.TransformPoint(p))
+	if !closeEnough(got.X, p.X) || !closeEnough(got.Y, p.Y) {
+		t.Errorf("Inverse round-trip: got %v, want %v", got, p)
+	}
+}
+
+func TestMatrixInverseDegenerate(t *testing.T) {
+	// A zero-determinant integer matrix must not panic, just return the
+	// zero Matrix.
+	got := Matrix[int]{}.Inverse()
+	if got != (Matrix[int]{}) {
+		t.Errorf("Inverse of degenerate matrix: got %v, want zero Matrix", got)
+	}
+}
+
+func TestMatrixTransformRectangle(t *testing.T) {
+	m := Rotation[float64](math.Pi / 2)
+	got := m.TransformRectangle(Rect(0.0, 0.0, 2.0, 1.0))
+	want := Rect(-1.0, 0.0, 0.0, 2.0)
+	if !closeEnough(got.Min.X, want.Min.X) || !closeEnough(got.Min.Y, want.Min.Y) ||
+		!closeEnough(got.Max.X, want.Max.X) || !closeEnough(got.Max.Y, want.Max.Y) {
+		t.Errorf("TransformRectangle: got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,160 @@
+package imggg
+
+// A Polygon is a sequence of vertices describing a (possibly non-convex)
+// simple polygon. The last vertex is implicitly connected back to the
+// first.
+type Polygon[V Number] []Point[V]
+
+// Bounds returns the smallest Rectangle containing every vertex of p. It
+// returns the zero Rectangle if p has no vertices.
+func (p Polygon[V]) Bounds() Rectangle[V] {
+	if len(p) == 0 {
+		return Rectangle[V]{}
+	}
+	r := Rectangle[V]{p[0], p[0]}
+	for _, v := range p[1:] {
+		if v.X < r.Min.X {
+			r.Min.X = v.X
+		}
+		if v.Y < r.Min.Y {
+			r.Min.Y = v.Y
+		}
+		if v.X > r.Max.X {
+			r.Max.X = v.X
+		}
+		if v.Y > r.Max.Y {
+			r.Max.Y = v.Y
+		}
+	}
+	return r
+}
+
+// Area returns the signed area of p, computed with the shoelace formula.
+// Since this package's axes increase right and down (see [Point]), the
+// result is positive if p's vertices wind clockwise as drawn on screen and
+// negative if they wind counter-clockwise.
+func (p Polygon[V]) Area() float64 {
+	if len(p) < 3 {
+		return 0
+	}
+	var sum float64
+	for i := range p {
+		j := (i + 1) % len(p)
+		sum += float64(p[i].X)*float64(p[j].Y) - float64(p[j].X)*float64(p[i].Y)
+	}
+	return sum / 2
+}
+
+// Centroid returns the centroid (center of mass) of p.
+func (p Polygon[V]) Centroid() Point[float64] {
+	if len(p) == 0 {
+		return Point[float64]{}
+	}
+	var cx, cy, a float64
+	for i := range p {
+		j := (i + 1) % len(p)
+		cross := float64(p[i].X)*float64(p[j].Y) - float64(p[j].X)*float64(p[i].Y)
+		cx += (float64(p[i].X) + float64(p[j].X)) * cross
+		cy += (float64(p[i].Y) + float64(p[j].Y)) * cross
+		a += cross
+	}
+	if a == 0 {
+		return Point[float64]{}
+	}
+	a /= 2
+	return Point[float64]{cx / (6 * a), cy / (6 * a)}
+}
+
+// Contains reports whether q lies inside p, using the even-odd rule. A
+// point exactly on an edge may be classified either way.
+func (p Polygon[V]) Contains(q Point[V]) bool {
+	inside := false
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		pi, pj := p[i], p[j]
+		if (pi.Y > q.Y) != (pj.Y > q.Y) {
+			x := float64(pj.X-pi.X)*float64(q.Y-pi.Y)/float64(pj.Y-pi.Y) + float64(pi.X)
+			if float64(q.X) < x {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// polyEdge is one of a Rectangle's four half-planes, used by
+// [Polygon.ClipTo] to clip a polygon one side at a time.
+type polyEdge[V Number] struct {
+	inside func(Point[V]) bool
+	// at returns the point where segment s->e crosses this edge.
+	at func(s, e Point[V]) Point[V]
+}
+
+// ClipTo returns the intersection of p with r, computed with the
+// Sutherland-Hodgman algorithm: p is clipped successively against each of
+// r's four sides (left, right, top, bottom). For each side, every
+// consecutive pair (S, E) of the current vertex list contributes E if E is
+// inside, the S-E/side intersection if S and E straddle the side, or
+// nothing if both are outside. p is assumed to be simple
+// (non-self-intersecting); the result may be empty if p and r do not
+// overlap.
+func (p Polygon[V]) ClipTo(r Rectangle[V]) Polygon[V] {
+	edges := [4]polyEdge[V]{
+		{ // left
+			inside: func(pt Point[V]) bool { return pt.X >= r.Min.X },
+			at: func(s, e Point[V]) Point[V] {
+				return lerpPoint(s, e, float64(r.Min.X-s.X)/float64(e.X-s.X))
+			},
+		},
+		{ // right
+			inside: func(pt Point[V]) bool { return pt.X <= r.Max.X },
+			at: func(s, e Point[V]) Point[V] {
+				return lerpPoint(s, e, float64(r.Max.X-s.X)/float64(e.X-s.X))
+			},
+		},
+		{ // top
+			inside: func(pt Point[V]) bool { return pt.Y >= r.Min.Y },
+			at: func(s, e Point[V]) Point[V] {
+				return lerpPoint(s, e, float64(r.Min.Y-s.Y)/float64(e.Y-s.Y))
+			},
+		},
+		{ // bottom
+			inside: func(pt Point[V]) bool { return pt.Y <= r.Max.Y },
+			at: func(s, e Point[V]) Point[V] {
+				return lerpPoint(s, e, float64(r.Max.Y-s.Y)/float64(e.Y-s.Y))
+			},
+		},
+	}
+
+	out := p
+	for _, ed := range edges {
+		if len(out) == 0 {
+			break
+		}
+		var in Polygon[V]
+		s := out[len(out)-1]
+		for _, e := range out {
+			switch {
+			case ed.inside(e):
+				if !ed.inside(s) {
+					in = append(in, ed.at(s, e))
+				}
+				in = append(in, e)
+			case ed.inside(s):
+				in = append(in, ed.at(s, e))
+			}
+			s = e
+		}
+		out = in
+	}
+	return out
+}
+
+// lerpPoint returns the point on segment s-e at parameter t. Unlike
+// [Point.Lerp], it stays in V rather than widening to float64, since ClipTo
+// needs the result back in the polygon's own coordinate type.
+func lerpPoint[V Number](s, e Point[V], t float64) Point[V] {
+	return Point[V]{
+		V(float64(s.X) + (float64(e.X)-float64(s.X))*t),
+		V(float64(s.Y) + (float64(e.Y)-float64(s.Y))*t),
+	}
+}
@@ -0,0 +1,52 @@
+package imggg
+
+import "testing"
+
+// square is wound clockwise as drawn on screen (right, down, left, up),
+// matching how callers built from this package's y-down Points will
+// typically enumerate a rectangle's corners.
+var square = Polygon[float64]{Pt(0.0, 0.0), Pt(4.0, 0.0), Pt(4.0, 4.0), Pt(0.0, 4.0)}
+
+func TestPolygonBounds(t *testing.T) {
+	if got, want := square.Bounds(), Rect(0.0, 0.0, 4.0, 4.0); got != want {
+		t.Errorf("Bounds: got %v, want %v", got, want)
+	}
+}
+
+func TestPolygonArea(t *testing.T) {
+	if got := square.Area(); got != 16 {
+		t.Errorf("Area: got %v, want 16 (clockwise-on-screen winding is positive)", got)
+	}
+}
+
+func TestPolygonCentroid(t *testing.T) {
+	if got := square.Centroid(); !closeEnough(got.X, 2) || !closeEnough(got.Y, 2) {
+		t.Errorf("Centroid: got %v, want (2,2)", got)
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	if !square.Contains(Pt(2.0, 2.0)) {
+		t.Errorf("Contains: (2,2) should be inside the square")
+	}
+	if square.Contains(Pt(5.0, 5.0)) {
+		t.Errorf("Contains: (5,5) should be outside the square")
+	}
+}
+
+func TestPolygonClipTo(t *testing.T) {
+	clipped := square.ClipTo(Rect(1.0, 1.0, 3.0, 3.0))
+	if got, want := clipped.Bounds(), Rect(1.0, 1.0, 3.0, 3.0); got != want {
+		t.Errorf("ClipTo bounds: got %v, want %v", got, want)
+	}
+	if got := clipped.Area(); !closeEnough(got, 4) {
+		t.Errorf("ClipTo area: got %v, want 4", got)
+	}
+}
+
+func TestPolygonClipToDisjoint(t *testing.T) {
+	clipped := square.ClipTo(Rect(10.0, 10.0, 12.0, 12.0))
+	if len(clipped) != 0 {
+		t.Errorf("ClipTo of a disjoint rectangle: got %v, want empty", clipped)
+	}
+}